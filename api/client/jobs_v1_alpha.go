@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"strconv"
 
 	models "github.com/semaphoreci/cli/api/models"
 )
@@ -32,6 +33,74 @@ func (c *JobsApiV1AlphaApi) ListJobs(states []string) (*models.JobListV1Alpha, e
 		query.Add("states", s)
 	}
 
+	return c.listJobsPage(query)
+}
+
+// Lists jobs in the given states that were created at or after createdAfter
+// (unix seconds), following next_page_token. Jobs are returned newest first,
+// so paging stops at the first page created entirely before createdAfter. If
+// projectId is not empty, only the jobs of that project are returned.
+func (c *JobsApiV1AlphaApi) ListAllJobs(states []string, projectId string, createdAfter int64) (*models.JobListV1Alpha, error) {
+	result := models.JobListV1Alpha{}
+	pageToken := ""
+
+	for {
+		query := url.Values{}
+
+		for _, s := range states {
+			query.Add("states", s)
+		}
+
+		if projectId != "" {
+			query.Set("project_id", projectId)
+		}
+
+		if createdAfter > 0 {
+			query.Set("created_after", strconv.FormatInt(createdAfter, 10))
+		}
+
+		if pageToken != "" {
+			query.Set("page_token", pageToken)
+		}
+
+		page, err := c.listJobsPage(query)
+
+		if err != nil {
+			return nil, err
+		}
+
+		olderThanWindow := 0
+
+		for _, j := range page.Jobs {
+			createTime, err := j.Metadata.CreateTime.Int64()
+
+			if err != nil || createTime < createdAfter {
+				olderThanWindow++
+				continue
+			}
+
+			if projectId != "" && j.Spec.ProjectId != projectId {
+				continue
+			}
+
+			result.Jobs = append(result.Jobs, j)
+		}
+
+		if len(page.Jobs) > 0 && olderThanWindow == len(page.Jobs) {
+			break
+		}
+
+		if page.NextPageToken == "" || page.NextPageToken == pageToken {
+			break
+		}
+
+		pageToken = page.NextPageToken
+	}
+
+	return &result, nil
+}
+
+func (c *JobsApiV1AlphaApi) listJobsPage(query url.Values) (*models.JobListV1Alpha, error) {
 	body, status, err := c.BaseClient.ListWithParams(c.ResourceNamePlural, query)
 
 	if err != nil {
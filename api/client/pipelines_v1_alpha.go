@@ -0,0 +1,89 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	models "github.com/semaphoreci/cli/api/models"
+)
+
+type PipelinesApiV1AlphaApi struct {
+	BaseClient           BaseClient
+	ResourceNameSingular string
+	ResourceNamePlural   string
+}
+
+func NewPipelinesV1AlphaApi() PipelinesApiV1AlphaApi {
+	baseClient := NewBaseClientFromConfig()
+	baseClient.SetApiVersion("v1alpha")
+
+	return PipelinesApiV1AlphaApi{
+		BaseClient:           baseClient,
+		ResourceNamePlural:   "pipelines",
+		ResourceNameSingular: "pipeline",
+	}
+}
+
+// Lists the pipelines of a project that were created at or after createdAfter
+// (unix seconds). Pipelines are returned newest first, one numbered page at a
+// time, so paging stops at an empty page or at the first page created
+// entirely before createdAfter.
+func (c *PipelinesApiV1AlphaApi) ListPipelines(projectId string, createdAfter int64) (*models.PipelineListV1Alpha, error) {
+	result := models.PipelineListV1Alpha{}
+	previousFirstId := ""
+
+	for page := 1; ; page++ {
+		query := url.Values{}
+
+		query.Set("project_id", projectId)
+		query.Set("page", strconv.Itoa(page))
+
+		list, err := c.listPipelinesPage(query)
+
+		if err != nil {
+			return nil, err
+		}
+
+		if len(list.Pipelines) == 0 || list.Pipelines[0].Id == previousFirstId {
+			break
+		}
+
+		previousFirstId = list.Pipelines[0].Id
+		olderThanWindow := 0
+
+		for _, p := range list.Pipelines {
+			if p.CreatedAt.Seconds < createdAfter {
+				olderThanWindow++
+				continue
+			}
+
+			if p.ProjectId != projectId {
+				continue
+			}
+
+			result.Pipelines = append(result.Pipelines, p)
+		}
+
+		if olderThanWindow == len(list.Pipelines) {
+			break
+		}
+	}
+
+	return &result, nil
+}
+
+func (c *PipelinesApiV1AlphaApi) listPipelinesPage(query url.Values) (*models.PipelineListV1Alpha, error) {
+	body, status, err := c.BaseClient.ListWithParams(c.ResourceNamePlural, query)
+
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("connecting to Semaphore failed '%s'", err))
+	}
+
+	if status != 200 {
+		return nil, errors.New(fmt.Sprintf("http status %d with message \"%s\" received from upstream", status, body))
+	}
+
+	return models.NewPipelineListV1AlphaFromJson(body)
+}
@@ -5,7 +5,8 @@ import (
 )
 
 type JobListV1Alpha struct {
-	Jobs []JobV1Alpha `json:"jobs" yaml:"jobs"`
+	Jobs          []JobV1Alpha `json:"jobs" yaml:"jobs"`
+	NextPageToken string       `json:"next_page_token,omitempty" yaml:"next_page_token,omitempty"`
 }
 
 func NewJobListV1AlphaFromJson(data []byte) (*JobListV1Alpha, error) {
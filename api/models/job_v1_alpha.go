@@ -20,6 +20,7 @@ type JobV1Alpha struct {
 	} `json:"metadata,omitempty"`
 
 	Spec struct {
+		ProjectId string `json:"project_id,omitempty" yaml:"project_id,omitempty"`
 	} `json:"spec,omitempty"`
 
 	Status struct {
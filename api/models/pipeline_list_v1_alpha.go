@@ -0,0 +1,19 @@
+package models
+
+import "encoding/json"
+
+type PipelineListV1Alpha struct {
+	Pipelines []PipelineV1Alpha `json:"pipelines" yaml:"pipelines"`
+}
+
+func NewPipelineListV1AlphaFromJson(data []byte) (*PipelineListV1Alpha, error) {
+	list := []PipelineV1Alpha{}
+
+	err := json.Unmarshal(data, &list)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &PipelineListV1Alpha{Pipelines: list}, nil
+}
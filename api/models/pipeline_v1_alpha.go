@@ -0,0 +1,34 @@
+package models
+
+import (
+	"encoding/json"
+)
+
+type PipelineTimestampV1Alpha struct {
+	Seconds int64 `json:"seconds" yaml:"seconds"`
+	Nanos   int32 `json:"nanos" yaml:"nanos"`
+}
+
+type PipelineV1Alpha struct {
+	Id         string                   `json:"ppl_id" yaml:"ppl_id"`
+	Name       string                   `json:"name" yaml:"name"`
+	ProjectId  string                   `json:"project_id" yaml:"project_id"`
+	BranchName string                   `json:"branch_name" yaml:"branch_name"`
+	State      string                   `json:"state" yaml:"state"`
+	Result     string                   `json:"result" yaml:"result"`
+	CreatedAt  PipelineTimestampV1Alpha `json:"created_at" yaml:"created_at"`
+	RunningAt  PipelineTimestampV1Alpha `json:"running_at" yaml:"running_at"`
+	DoneAt     PipelineTimestampV1Alpha `json:"done_at" yaml:"done_at"`
+}
+
+func NewPipelineV1AlphaFromJson(data []byte) (*PipelineV1Alpha, error) {
+	p := PipelineV1Alpha{}
+
+	err := json.Unmarshal(data, &p)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return &p, nil
+}
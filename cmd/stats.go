@@ -0,0 +1,318 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	client "github.com/semaphoreci/cli/api/client"
+	models "github.com/semaphoreci/cli/api/models"
+	"github.com/semaphoreci/cli/cmd/utils"
+	"github.com/spf13/cobra"
+)
+
+type DurationStats struct {
+	Avg int64 `json:"avg_seconds"`
+	P50 int64 `json:"p50_seconds"`
+	P95 int64 `json:"p95_seconds"`
+	Max int64 `json:"max_seconds"`
+}
+
+type ProjectStats struct {
+	Project   string        `json:"project"`
+	Kind      string        `json:"kind"`
+	Count     int           `json:"count"`
+	Skipped   int           `json:"skipped"`
+	QueueTime DurationStats `json:"queue_time"`
+	Duration  DurationStats `json:"duration"`
+}
+
+type statsSample struct {
+	project    string
+	createTime int64
+	startTime  int64
+	finishTime int64
+}
+
+var StatsSince string
+var StatsJson bool
+
+var statsCmd = &cobra.Command{
+	Use:   "stats [PROJECT NAME]",
+	Short: "Display job and pipeline duration and queue time statistics.",
+	Long: `Aggregates the duration and queue time of finished jobs and pipelines per
+project.
+
+Only jobs and pipelines created within the --since window are included. The
+window accepts Go durations (e.g. 12h, 90m) and days (e.g. 7d).
+
+Jobs and pipelines without a start time, or with out of order timestamps, are
+reported as skipped and left out of the statistics.`,
+	Args: cobra.RangeArgs(0, 1),
+
+	Run: func(cmd *cobra.Command, args []string) {
+		window, err := parseStatsWindow(StatsSince)
+
+		utils.Check(err)
+
+		since := time.Now().Add(-window).Unix()
+
+		projects := []models.ProjectV1Alpha{}
+		projectId := ""
+
+		pc := client.NewProjectV1AlphaApi()
+
+		if len(args) == 1 {
+			project, err := pc.GetProject(args[0])
+
+			utils.Check(err)
+
+			projectId = project.Metadata.Id
+			projects = append(projects, *project)
+		} else {
+			projectList, err := pc.ListProjects()
+
+			utils.Check(err)
+
+			projects = projectList.Projects
+		}
+
+		projectNames := map[string]string{}
+
+		for _, p := range projects {
+			projectNames[p.Metadata.Id] = p.Metadata.Name
+		}
+
+		projectName := func(id string) string {
+			if name, ok := projectNames[id]; ok {
+				return name
+			}
+
+			return id
+		}
+
+		jc := client.NewJobsV1AlphaApi()
+		jobList, err := jc.ListAllJobs([]string{"FINISHED"}, projectId, since)
+
+		utils.Check(err)
+
+		jobSamples := []statsSample{}
+
+		for _, j := range jobList.Jobs {
+			sample, err := jobStatsSample(j, projectName)
+
+			utils.Check(err)
+
+			jobSamples = append(jobSamples, sample)
+		}
+
+		ppc := client.NewPipelinesV1AlphaApi()
+		pipelineSamples := []statsSample{}
+
+		for _, p := range projects {
+			pipelineList, err := ppc.ListPipelines(p.Metadata.Id, since)
+
+			utils.Check(err)
+
+			for _, ppl := range pipelineList.Pipelines {
+				if ppl.State != "DONE" {
+					continue
+				}
+
+				pipelineSamples = append(pipelineSamples, statsSample{
+					project:    projectName(ppl.ProjectId),
+					createTime: ppl.CreatedAt.Seconds,
+					startTime:  ppl.RunningAt.Seconds,
+					finishTime: ppl.DoneAt.Seconds,
+				})
+			}
+		}
+
+		stats := append(aggregateStats("job", jobSamples), aggregateStats("pipeline", pipelineSamples)...)
+
+		sort.SliceStable(stats, func(i, k int) bool {
+			return stats[i].Project < stats[k].Project
+		})
+
+		if StatsJson {
+			j, err := json.MarshalIndent(stats, "", "  ")
+
+			utils.Check(err)
+
+			fmt.Printf("%s\n", j)
+
+			return
+		}
+
+		const padding = 3
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, padding, ' ', 0)
+
+		fmt.Fprintln(w, "PROJECT\tKIND\tCOUNT\tSKIPPED\t"+
+			"AVG QUEUE\tP50 QUEUE\tP95 QUEUE\tMAX QUEUE\t"+
+			"AVG DURATION\tP50 DURATION\tP95 DURATION\tMAX DURATION")
+
+		for _, s := range stats {
+			fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+				s.Project,
+				s.Kind,
+				s.Count,
+				s.Skipped,
+				utils.DurationForHumans(s.QueueTime.Avg),
+				utils.DurationForHumans(s.QueueTime.P50),
+				utils.DurationForHumans(s.QueueTime.P95),
+				utils.DurationForHumans(s.QueueTime.Max),
+				utils.DurationForHumans(s.Duration.Avg),
+				utils.DurationForHumans(s.Duration.P50),
+				utils.DurationForHumans(s.Duration.P95),
+				utils.DurationForHumans(s.Duration.Max))
+		}
+
+		w.Flush()
+	},
+}
+
+func parseStatsWindow(value string) (time.Duration, error) {
+	if strings.HasSuffix(value, "d") {
+		days, err := strconv.Atoi(strings.TrimSuffix(value, "d"))
+
+		if err != nil || days <= 0 {
+			return 0, fmt.Errorf("invalid time window '%s'", value)
+		}
+
+		return time.Duration(days) * 24 * time.Hour, nil
+	}
+
+	d, err := time.ParseDuration(value)
+
+	if err != nil || d <= 0 {
+		return 0, fmt.Errorf("invalid time window '%s'", value)
+	}
+
+	return d, nil
+}
+
+func jobStatsSample(j models.JobV1Alpha, projectName func(string) string) (statsSample, error) {
+	createTime, err := jobTimestamp(j.Metadata.CreateTime)
+
+	if err != nil {
+		return statsSample{}, err
+	}
+
+	startTime, err := jobTimestamp(j.Metadata.StartTime)
+
+	if err != nil {
+		return statsSample{}, err
+	}
+
+	finishTime, err := jobTimestamp(j.Metadata.FinishTime)
+
+	if err != nil {
+		return statsSample{}, err
+	}
+
+	return statsSample{
+		project:    projectName(j.Spec.ProjectId),
+		createTime: createTime,
+		startTime:  startTime,
+		finishTime: finishTime,
+	}, nil
+}
+
+func jobTimestamp(n json.Number) (int64, error) {
+	if n == "" {
+		return 0, nil
+	}
+
+	return n.Int64()
+}
+
+// Groups samples per project. Only samples with a start time and ordered
+// timestamps are counted and summarized, the rest are reported as skipped.
+func aggregateStats(kind string, samples []statsSample) []ProjectStats {
+	queueTimes := map[string][]int64{}
+	durations := map[string][]int64{}
+	skipped := map[string]int{}
+	seen := map[string]bool{}
+	projects := []string{}
+
+	for _, s := range samples {
+		if !seen[s.project] {
+			seen[s.project] = true
+			projects = append(projects, s.project)
+		}
+
+		if s.startTime <= 0 || s.startTime < s.createTime || s.finishTime < s.startTime {
+			skipped[s.project]++
+			continue
+		}
+
+		queueTimes[s.project] = append(queueTimes[s.project], s.startTime-s.createTime)
+		durations[s.project] = append(durations[s.project], s.finishTime-s.startTime)
+	}
+
+	sort.Strings(projects)
+
+	stats := []ProjectStats{}
+
+	for _, name := range projects {
+		stats = append(stats, ProjectStats{
+			Project:   name,
+			Kind:      kind,
+			Count:     len(durations[name]),
+			Skipped:   skipped[name],
+			QueueTime: summarizeDurations(queueTimes[name]),
+			Duration:  summarizeDurations(durations[name]),
+		})
+	}
+
+	return stats
+}
+
+func summarizeDurations(values []int64) DurationStats {
+	if len(values) == 0 {
+		return DurationStats{}
+	}
+
+	sorted := append([]int64{}, values...)
+
+	sort.Slice(sorted, func(i, k int) bool {
+		return sorted[i] < sorted[k]
+	})
+
+	var sum int64
+
+	for _, v := range sorted {
+		sum += v
+	}
+
+	return DurationStats{
+		Avg: sum / int64(len(sorted)),
+		P50: percentile(sorted, 0.50),
+		P95: percentile(sorted, 0.95),
+		Max: sorted[len(sorted)-1],
+	}
+}
+
+// nearest-rank percentile of an already sorted slice
+func percentile(sorted []int64, p float64) int64 {
+	rank := int(math.Ceil(p * float64(len(sorted))))
+
+	if rank < 1 {
+		rank = 1
+	}
+
+	return sorted[rank-1]
+}
+
+func init() {
+	statsCmd.Flags().StringVar(&StatsSince, "since", "7d", "time window of jobs to include, e.g. 24h or 7d")
+	statsCmd.Flags().BoolVar(&StatsJson, "json", false, "output statistics as JSON")
+
+	RootCmd.AddCommand(statsCmd)
+}
@@ -0,0 +1,312 @@
+package cmd
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	client "github.com/semaphoreci/cli/api/client"
+	httpmock "gopkg.in/jarcoal/httpmock.v1"
+)
+
+const statsProjectId = "8f100520-5ab9-469f-854a-87bae95f19b9"
+
+func statsJobJson(id string, projectId string, createTime int64) string {
+	return fmt.Sprintf(`{
+		"metadata": {
+			"name":"build",
+			"id":"%s",
+			"create_time":"%d",
+			"start_time":"%d",
+			"finish_time":"%d"
+		},
+		"spec": {
+			"project_id":"%s"
+		},
+		"status": {
+			"state":"FINISHED",
+			"result":"PASSED"
+		}
+	}`, id, createTime, createTime+10, createTime+70, projectId)
+}
+
+func statsPipelineJson(id string, projectId string, createTime int64) string {
+	return fmt.Sprintf(`{
+		"ppl_id":"%s",
+		"name":"Pipeline",
+		"project_id":"%s",
+		"state":"DONE",
+		"result":"PASSED",
+		"created_at":{"seconds":%d,"nanos":0},
+		"running_at":{"seconds":%d,"nanos":0},
+		"done_at":{"seconds":%d,"nanos":0}
+	}`, id, projectId, createTime, createTime+5, createTime+125)
+}
+
+func Test__Stats__Response200(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	jobsReceived := false
+	pipelinesReceived := false
+
+	httpmock.RegisterResponder("GET", "https://org.semaphoretext.xyz/api/v1alpha/projects/advent",
+		func(req *http.Request) (*http.Response, error) {
+			p1 := fmt.Sprintf(`{
+				"spec": {
+					"repository": {
+						"url" : "git@github.com:shiroyasha/advent-of-code-2017.git"
+					}
+				},
+				"metadata": {
+					"name":"advent",
+					"id":"%s"
+				},
+				"kind":"Project",
+				"apiVersion":"v1alpha"
+			}`, statsProjectId)
+
+			return httpmock.NewStringResponse(200, p1), nil
+		},
+	)
+
+	httpmock.RegisterResponder("GET", "https://org.semaphoretext.xyz/api/v1alpha/jobs",
+		func(req *http.Request) (*http.Response, error) {
+			jobsReceived = true
+
+			if req.URL.Query().Get("project_id") != statsProjectId {
+				t.Errorf("Expected jobs to be filtered by project, got '%s'", req.URL.RawQuery)
+			}
+
+			if req.URL.Query().Get("created_after") == "" {
+				t.Errorf("Expected jobs to be filtered by creation time, got '%s'", req.URL.RawQuery)
+			}
+
+			jobs := fmt.Sprintf(`{"jobs":[%s]}`, statsJobJson("j1", statsProjectId, currentUnixTime()))
+
+			return httpmock.NewStringResponse(200, jobs), nil
+		},
+	)
+
+	httpmock.RegisterResponder("GET", "https://org.semaphoretext.xyz/api/v1alpha/pipelines",
+		func(req *http.Request) (*http.Response, error) {
+			pipelinesReceived = true
+
+			if req.URL.Query().Get("project_id") != statsProjectId {
+				t.Errorf("Expected pipelines to be filtered by project, got '%s'", req.URL.RawQuery)
+			}
+
+			if req.URL.Query().Get("page") != "1" {
+				return httpmock.NewStringResponse(200, "[]"), nil
+			}
+
+			pipelines := fmt.Sprintf("[%s]", statsPipelineJson("p1", statsProjectId, currentUnixTime()))
+
+			return httpmock.NewStringResponse(200, pipelines), nil
+		},
+	)
+
+	RootCmd.SetArgs([]string{"stats", "advent", "--since", "24h"})
+	RootCmd.Execute()
+
+	if jobsReceived == false {
+		t.Error("Expected the API to receive GET jobs")
+	}
+
+	if pipelinesReceived == false {
+		t.Error("Expected the API to receive GET pipelines")
+	}
+}
+
+func Test__ListAllJobs__FollowsNextPageToken(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	now := currentUnixTime()
+	requests := 0
+
+	httpmock.RegisterResponder("GET", "https://org.semaphoretext.xyz/api/v1alpha/jobs",
+		func(req *http.Request) (*http.Response, error) {
+			requests++
+
+			switch req.URL.Query().Get("page_token") {
+			case "":
+				jobs := fmt.Sprintf(`{"jobs":[%s,%s],"next_page_token":"page-2"}`,
+					statsJobJson("j1", statsProjectId, now),
+					statsJobJson("other", "1f100520-5ab9-469f-854a-87bae95f19b9", now))
+
+				return httpmock.NewStringResponse(200, jobs), nil
+			case "page-2":
+				jobs := fmt.Sprintf(`{"jobs":[%s,%s]}`,
+					statsJobJson("j2", statsProjectId, now-60),
+					statsJobJson("no-project", "", now-60))
+
+				return httpmock.NewStringResponse(200, jobs), nil
+			default:
+				t.Errorf("Unexpected page token in '%s'", req.URL.RawQuery)
+
+				return httpmock.NewStringResponse(400, ""), nil
+			}
+		},
+	)
+
+	c := client.NewJobsV1AlphaApi()
+	jobList, err := c.ListAllJobs([]string{"FINISHED"}, statsProjectId, now-3600)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got '%s'", err)
+	}
+
+	if requests != 2 {
+		t.Errorf("Expected 2 page requests, got %d", requests)
+	}
+
+	if len(jobList.Jobs) != 2 || jobList.Jobs[0].Metadata.Id != "j1" || jobList.Jobs[1].Metadata.Id != "j2" {
+		t.Errorf("Expected jobs j1 and j2 of the project from both pages, got %+v", jobList.Jobs)
+	}
+}
+
+func Test__ListAllJobs__StopsOnRepeatedPageToken(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	now := currentUnixTime()
+	requests := 0
+
+	httpmock.RegisterResponder("GET", "https://org.semaphoretext.xyz/api/v1alpha/jobs",
+		func(req *http.Request) (*http.Response, error) {
+			requests++
+
+			if requests > 3 {
+				return httpmock.NewStringResponse(500, "paging did not stop"), nil
+			}
+
+			jobs := fmt.Sprintf(`{"jobs":[%s],"next_page_token":"same"}`, statsJobJson("j1", statsProjectId, now))
+
+			return httpmock.NewStringResponse(200, jobs), nil
+		},
+	)
+
+	c := client.NewJobsV1AlphaApi()
+	_, err := c.ListAllJobs([]string{"FINISHED"}, statsProjectId, now-3600)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got '%s'", err)
+	}
+
+	if requests != 2 {
+		t.Errorf("Expected 2 page requests, got %d", requests)
+	}
+}
+
+func Test__ListAllJobs__StopsAtPageOlderThanWindow(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	now := currentUnixTime()
+	requests := 0
+
+	httpmock.RegisterResponder("GET", "https://org.semaphoretext.xyz/api/v1alpha/jobs",
+		func(req *http.Request) (*http.Response, error) {
+			requests++
+
+			jobs := fmt.Sprintf(`{"jobs":[%s],"next_page_token":"page-%d"}`,
+				statsJobJson("old", statsProjectId, now-7200), requests+1)
+
+			return httpmock.NewStringResponse(200, jobs), nil
+		},
+	)
+
+	c := client.NewJobsV1AlphaApi()
+	jobList, err := c.ListAllJobs([]string{"FINISHED"}, statsProjectId, now-3600)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got '%s'", err)
+	}
+
+	if requests != 1 {
+		t.Errorf("Expected paging to stop after the first page, got %d requests", requests)
+	}
+
+	if len(jobList.Jobs) != 0 {
+		t.Errorf("Expected no jobs, got %+v", jobList.Jobs)
+	}
+}
+
+func Test__ListPipelines__FollowsPages(t *testing.T) {
+	httpmock.Activate()
+	defer httpmock.DeactivateAndReset()
+
+	now := currentUnixTime()
+	requests := 0
+
+	httpmock.RegisterResponder("GET", "https://org.semaphoretext.xyz/api/v1alpha/pipelines",
+		func(req *http.Request) (*http.Response, error) {
+			requests++
+
+			switch req.URL.Query().Get("page") {
+			case "1":
+				return httpmock.NewStringResponse(200, fmt.Sprintf("[%s]", statsPipelineJson("p1", statsProjectId, now))), nil
+			case "2":
+				return httpmock.NewStringResponse(200, fmt.Sprintf("[%s]", statsPipelineJson("p2", statsProjectId, now-60))), nil
+			default:
+				return httpmock.NewStringResponse(200, "[]"), nil
+			}
+		},
+	)
+
+	c := client.NewPipelinesV1AlphaApi()
+	pipelineList, err := c.ListPipelines(statsProjectId, now-3600)
+
+	if err != nil {
+		t.Fatalf("Expected no error, got '%s'", err)
+	}
+
+	if requests != 3 {
+		t.Errorf("Expected 3 page requests, got %d", requests)
+	}
+
+	if len(pipelineList.Pipelines) != 2 {
+		t.Errorf("Expected pipelines from both pages, got %+v", pipelineList.Pipelines)
+	}
+}
+
+func Test__AggregateStats(t *testing.T) {
+	samples := []statsSample{
+		{project: "a", createTime: 100, startTime: 110, finishTime: 170},
+		{project: "a", createTime: 200, startTime: 230, finishTime: 330},
+		{project: "a", createTime: 300, startTime: 0, finishTime: 0},
+		{project: "b", createTime: 300, startTime: 290, finishTime: 320},
+	}
+
+	stats := aggregateStats("job", samples)
+
+	if len(stats) != 2 {
+		t.Fatalf("Expected stats for 2 projects, got %d", len(stats))
+	}
+
+	a := stats[0]
+
+	if a.Project != "a" || a.Kind != "job" || a.Count != 2 || a.Skipped != 1 {
+		t.Errorf("Expected 2 counted and 1 skipped job for project a, got %+v", a)
+	}
+
+	if a.QueueTime.Avg != 20 || a.QueueTime.P50 != 10 || a.QueueTime.Max != 30 {
+		t.Errorf("Unexpected queue time stats %+v", a.QueueTime)
+	}
+
+	if a.Duration.Avg != 80 || a.Duration.P50 != 60 || a.Duration.P95 != 100 {
+		t.Errorf("Unexpected duration stats %+v", a.Duration)
+	}
+
+	b := stats[1]
+
+	if b.Count != 0 || b.Skipped != 1 {
+		t.Errorf("Expected jobs with out of order timestamps to be skipped, got %+v", b)
+	}
+}
+
+func currentUnixTime() int64 {
+	return time.Now().Unix()
+}
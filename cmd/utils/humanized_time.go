@@ -29,6 +29,18 @@ func RelativeAgeForHumans(timestamp int64) string {
 	return fmt.Sprintf("%dd", days)
 }
 
+func DurationForHumans(seconds int64) string {
+	if seconds < 60 {
+		return fmt.Sprintf("%ds", seconds)
+	}
+
+	if seconds < 3600 {
+		return fmt.Sprintf("%dm%02ds", seconds/60, seconds%60)
+	}
+
+	return fmt.Sprintf("%dh%02dm", seconds/3600, (seconds%3600)/60)
+}
+
 func currentTimestamp() int64 {
 	return time.Now().UnixNano() / 1e9
 }